@@ -0,0 +1,17 @@
+// Package cache provides pluggable stores for caching the raw responses a
+// fixer.Client receives, so repeated requests for the same rates don't have
+// to hit the upstream API again.
+package cache
+
+import "time"
+
+// Cache stores and retrieves byte blobs against a key. Implementations are
+// expected to be safe for concurrent use.
+type Cache interface {
+	// Get returns the value stored for key, and whether it was found and
+	// has not expired
+	Get(key string) ([]byte, bool)
+
+	// Set stores value for key. A zero ttl means the value never expires.
+	Set(key string, value []byte, ttl time.Duration)
+}
@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// File is a Cache that stores one file per key under Dir
+type File struct {
+	Dir string
+}
+
+// NewFile creates a File cache rooted at dir, creating the directory if it
+// doesn't already exist
+func NewFile(dir string) (*File, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &File{Dir: dir}, nil
+}
+
+type fileEntry struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	Value     []byte    `json:"value"`
+}
+
+func (f *File) path(key string) string {
+	return filepath.Join(f.Dir, url.QueryEscape(key)+".json")
+}
+
+// Get returns the value stored for key, and whether it was found and has
+// not expired
+func (f *File) Get(key string) ([]byte, bool) {
+	b, err := ioutil.ReadFile(f.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	var e fileEntry
+
+	if err := json.Unmarshal(b, &e); err != nil {
+		return nil, false
+	}
+
+	if !e.ExpiresAt.IsZero() && time.Now().After(e.ExpiresAt) {
+		_ = os.Remove(f.path(key))
+		return nil, false
+	}
+
+	return e.Value, true
+}
+
+// Set stores value for key in its own file. A zero ttl means the value
+// never expires.
+func (f *File) Set(key string, value []byte, ttl time.Duration) {
+	var expiresAt time.Time
+
+	switch {
+	case ttl > 0:
+		expiresAt = time.Now().Add(ttl)
+	case ttl < 0:
+		expiresAt = time.Now().Add(-time.Nanosecond)
+	}
+
+	b, err := json.Marshal(fileEntry{ExpiresAt: expiresAt, Value: value})
+	if err != nil {
+		return
+	}
+
+	_ = ioutil.WriteFile(f.path(key), b, 0o644)
+}
+
+var _ Cache = (*File)(nil)
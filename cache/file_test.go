@@ -0,0 +1,36 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFile(t *testing.T) {
+	f, err := NewFile(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, ok := f.Get("missing"); ok {
+		t.Fatal("Get(\"missing\") returned ok = true")
+	}
+
+	f.Set("a", []byte("1"), 0)
+
+	if got, ok := f.Get("a"); !ok || string(got) != "1" {
+		t.Fatalf("Get(\"a\") = %q, %v, want %q, true", got, ok, "1")
+	}
+}
+
+func TestFileExpiry(t *testing.T) {
+	f, err := NewFile(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	f.Set("a", []byte("1"), -time.Second)
+
+	if _, ok := f.Get("a"); ok {
+		t.Fatal("Get(\"a\") = true for an already-expired entry")
+	}
+}
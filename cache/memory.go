@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Memory is an in-memory Cache that evicts the least recently used entry
+// once it reaches its capacity
+type Memory struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+type memoryEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewMemory creates a Memory cache that holds at most capacity entries
+func NewMemory(capacity int) *Memory {
+	return &Memory{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// Get returns the value stored for key, and whether it was found and has
+// not expired
+func (m *Memory) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	el, ok := m.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := el.Value.(*memoryEntry)
+
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		m.removeElement(el)
+		return nil, false
+	}
+
+	m.ll.MoveToFront(el)
+
+	return entry.value, true
+}
+
+// Set stores value for key, evicting the least recently used entry if the
+// cache is at capacity. A zero ttl means the value never expires.
+func (m *Memory) Set(key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var expiresAt time.Time
+
+	switch {
+	case ttl > 0:
+		expiresAt = time.Now().Add(ttl)
+	case ttl < 0:
+		expiresAt = time.Now().Add(-time.Nanosecond)
+	}
+
+	if el, ok := m.items[key]; ok {
+		m.ll.MoveToFront(el)
+		entry := el.Value.(*memoryEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+
+		return
+	}
+
+	el := m.ll.PushFront(&memoryEntry{key: key, value: value, expiresAt: expiresAt})
+	m.items[key] = el
+
+	for m.capacity > 0 && m.ll.Len() > m.capacity {
+		m.removeElement(m.ll.Back())
+	}
+}
+
+func (m *Memory) removeElement(el *list.Element) {
+	m.ll.Remove(el)
+	delete(m.items, el.Value.(*memoryEntry).key)
+}
+
+var _ Cache = (*Memory)(nil)
@@ -0,0 +1,41 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMemory(t *testing.T) {
+	m := NewMemory(2)
+
+	m.Set("a", []byte("1"), 0)
+	m.Set("b", []byte("2"), 0)
+
+	if _, ok := m.Get("missing"); ok {
+		t.Fatal("Get(\"missing\") returned ok = true")
+	}
+
+	if got, ok := m.Get("a"); !ok || string(got) != "1" {
+		t.Fatalf("Get(\"a\") = %q, %v, want %q, true", got, ok, "1")
+	}
+
+	m.Set("c", []byte("3"), 0)
+
+	if _, ok := m.Get("b"); ok {
+		t.Fatal("Get(\"b\") = true after eviction, want false")
+	}
+
+	if _, ok := m.Get("a"); !ok {
+		t.Fatal("Get(\"a\") = false, want true (recently used, shouldn't be evicted)")
+	}
+}
+
+func TestMemoryExpiry(t *testing.T) {
+	m := NewMemory(10)
+
+	m.Set("a", []byte("1"), -time.Second)
+
+	if _, ok := m.Get("a"); ok {
+		t.Fatal("Get(\"a\") = true for an already-expired entry")
+	}
+}
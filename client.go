@@ -3,14 +3,23 @@ package fixer
 import (
 	"context"
 	"encoding/json"
-	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
 	"time"
+
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
+
+	"github.com/peterhellberg/fixer/cache"
 )
 
+// defaultCacheTTL is how long a cached /latest response is trusted for
+// before the Client fetches a fresh one. Historical rates, which never
+// change once published, are cached indefinitely.
+const defaultCacheTTL = 10 * time.Minute
+
 // FixerClient is a client configured to use https://api.fixer.io
 var FixerClient = NewClient(AccessKey(os.Getenv("FIXER_ACCESS_KEY")))
 
@@ -26,8 +35,21 @@ type Client struct {
 	baseURL    *url.URL
 	accessKey  string
 	userAgent  string
+	exchanger  Exchanger
+
+	cache    cache.Cache
+	cacheTTL time.Duration
+	sf       singleflight.Group
+
+	retry   *RetryPolicy
+	limiter *rate.Limiter
+
+	strictCurrencies bool
 }
 
+// var _ ensures Client satisfies Exchanger
+var _ Exchanger = (*Client)(nil)
+
 // NewClient creates a Client
 func NewClient(options ...func(*Client)) *Client {
 	c := &Client{
@@ -41,6 +63,7 @@ func NewClient(options ...func(*Client)) *Client {
 		},
 		accessKey: "",
 		userAgent: "fixer/client.go (https://github.com/peterhellberg/fixer)",
+		cacheTTL:  defaultCacheTTL,
 	}
 
 	for _, f := range options {
@@ -80,6 +103,35 @@ func UserAgent(ua string) func(*Client) {
 	}
 }
 
+// Provider makes the Client delegate Latest, At and TimeSeries to the given Exchanger
+// instead of talking to api.fixer.io itself, so a Client can be backed by
+// any of the fixer/providers backends (or a Chain of them) without its
+// callers having to change.
+func Provider(e Exchanger) func(*Client) {
+	return func(c *Client) {
+		c.exchanger = e
+	}
+}
+
+// WithCache makes the Client cache /latest and historical responses in c,
+// keyed by endpoint, base and symbols, and coalesce concurrent requests for
+// the same key into a single upstream GET.
+func WithCache(c cache.Cache) func(*Client) {
+	return func(cl *Client) {
+		cl.cache = c
+	}
+}
+
+// CacheTTL changes how long a cached /latest response is trusted for before
+// being refetched. It has no effect on historical rates, which are cached
+// indefinitely since they never change once published. The default is 10
+// minutes.
+func CacheTTL(d time.Duration) func(*Client) {
+	return func(c *Client) {
+		c.cacheTTL = d
+	}
+}
+
 // Base sets the base query variable based on a Currency
 func Base(c Currency) url.Values {
 	v := url.Values{}
@@ -104,12 +156,91 @@ func Symbols(cs ...Currency) url.Values {
 
 // Latest foreign exchange reference rates
 func (c *Client) Latest(ctx context.Context, attributes ...url.Values) (*Response, error) {
-	return c.get(ctx, "/latest", c.query(attributes))
+	if c.exchanger != nil {
+		return c.exchanger.Latest(ctx, attributes...)
+	}
+
+	query := c.query(attributes)
+
+	if err := c.validateQuery(query); err != nil {
+		return nil, err
+	}
+
+	return c.cachedGet(ctx, "/latest", query, c.cacheTTL)
 }
 
 // At returns historical rates for any day since 1999
 func (c *Client) At(ctx context.Context, t time.Time, attributes ...url.Values) (*Response, error) {
-	return c.get(ctx, "/"+c.date(t), c.query(attributes))
+	if c.exchanger != nil {
+		return c.exchanger.At(ctx, t, attributes...)
+	}
+
+	query := c.query(attributes)
+
+	if err := c.validateQuery(query); err != nil {
+		return nil, err
+	}
+
+	// A historical rate never changes once published, so it is cached
+	// indefinitely (ttl 0) rather than using cacheTTL.
+	return c.cachedGet(ctx, "/"+c.date(t), query, 0)
+}
+
+// cachedGet is get, but serving from and populating c.cache when one is
+// configured, coalescing concurrent requests for the same key.
+func (c *Client) cachedGet(ctx context.Context, path string, query url.Values, ttl time.Duration) (*Response, error) {
+	if c.cache == nil {
+		return c.get(ctx, path, query)
+	}
+
+	key := cacheKey(path, query)
+
+	if b, ok := c.cache.Get(key); ok {
+		var r Response
+
+		if err := json.Unmarshal(b, &r); err == nil {
+			return &r, nil
+		}
+	}
+
+	v, err, _ := c.sf.Do(key, func() (interface{}, error) {
+		return c.get(ctx, path, query)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := v.(*Response)
+
+	if b, err := json.Marshal(r); err == nil {
+		c.cache.Set(key, b, ttl)
+	}
+
+	return r, nil
+}
+
+// cacheKey canonicalises a request into (endpoint, base, symbols) so the
+// same rates are served from the cache regardless of attribute order.
+// Symbols is already sorted by the Symbols helper.
+func cacheKey(path string, query url.Values) string {
+	return path + "?base=" + query.Get("base") + "&symbols=" + query.Get("symbols")
+}
+
+// Prewarm fetches and caches rates for each of dates, so subsequent At
+// calls for those dates are served from the cache. It is a no-op unless the
+// Client was created with WithCache.
+func (c *Client) Prewarm(ctx context.Context, dates []time.Time, base Currency, symbols Currencies) error {
+	if c.cache == nil {
+		return nil
+	}
+
+	for _, d := range dates {
+		if _, err := c.At(ctx, d, Base(base), Symbols(symbols...)); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (c *Client) date(t time.Time) string {
@@ -181,26 +312,40 @@ func (c *Client) request(ctx context.Context, path string, query url.Values) (*h
 }
 
 func (c *Client) do(req *http.Request) (*Response, error) {
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
+	var r Response
+
+	if err := c.doInto(req, &r); err != nil {
 		return nil, err
 	}
+
+	return &r, nil
+}
+
+// doInto executes req and decodes the response body into v, using the same
+// error handling as do
+func (c *Client) doInto(req *http.Request, v interface{}) error {
+	resp, err := c.doHTTP(req)
+	if err != nil {
+		return err
+	}
 	defer func() {
-		_, _ = io.CopyN(ioutil.Discard, resp.Body, 64)
 		_ = resp.Body.Close()
 	}()
 
-	if err := responseError(resp); err != nil {
-		return nil, err
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
 	}
 
-	var r Response
+	if resp.StatusCode != http.StatusOK {
+		if e := decodeError(body, resp.StatusCode, req.URL.String()); e != nil {
+			return e
+		}
 
-	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
-		return nil, err
+		return responseError(resp)
 	}
 
-	return &r, nil
+	return json.Unmarshal(body, v)
 }
 
 // Latest foreign exchange reference rates using the DefaultClient
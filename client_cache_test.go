@@ -0,0 +1,106 @@
+package fixer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/peterhellberg/fixer/cache"
+)
+
+func TestClientWithCache(t *testing.T) {
+	var hits int32
+
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&hits, 1)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"base":"EUR","date":"2024-01-02","rates":{"SEK":11.2}}`))
+		}))
+	defer ts.Close()
+
+	c := NewClient(BaseURL(ts.URL), WithCache(cache.NewMemory(10)))
+
+	if _, err := c.Latest(context.Background(), Base(EUR), Symbols(SEK)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := c.Latest(context.Background(), Base(EUR), Symbols(SEK)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := atomic.LoadInt32(&hits), int32(1); got != want {
+		t.Fatalf("upstream hits = %d, want %d", got, want)
+	}
+}
+
+func TestClientWithCacheCoalescesConcurrentRequests(t *testing.T) {
+	var hits int32
+
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&hits, 1)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"base":"EUR","date":"2024-01-02","rates":{"SEK":11.2}}`))
+		}))
+	defer ts.Close()
+
+	c := NewClient(BaseURL(ts.URL), WithCache(cache.NewMemory(10)))
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if _, err := c.Latest(context.Background(), Base(EUR), Symbols(SEK)); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if got, want := atomic.LoadInt32(&hits), int32(1); got != want {
+		t.Fatalf("upstream hits = %d, want %d", got, want)
+	}
+}
+
+func TestClientPrewarm(t *testing.T) {
+	var hits int32
+
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&hits, 1)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"base":"EUR","date":"2012-03-28","rates":{"SEK":8.9}}`))
+		}))
+	defer ts.Close()
+
+	c := NewClient(BaseURL(ts.URL), WithCache(cache.NewMemory(10)))
+
+	dates := []time.Time{
+		time.Date(2012, 3, 28, 0, 0, 0, 0, time.UTC),
+	}
+
+	if err := c.Prewarm(context.Background(), dates, EUR, Currencies{SEK}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := c.At(context.Background(), dates[0], Base(EUR), Symbols(SEK)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := atomic.LoadInt32(&hits), int32(1); got != want {
+		t.Fatalf("upstream hits = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,30 @@
+package fixer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientSurfacesProviderError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"success":false,"error":{"code":104,"type":"usage_limit_reached","info":"Your monthly usage limit has been reached"}}`))
+		}))
+	defer ts.Close()
+
+	c := NewClient(BaseURL(ts.URL))
+
+	_, err := c.Latest(context.Background())
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if !errors.Is(err, ErrUsageLimitReached) {
+		t.Fatalf("errors.Is(err, ErrUsageLimitReached) = false for %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package fixer
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// ConvertOption configures a Client.Convert call
+type ConvertOption func(*convertConfig)
+
+type convertConfig struct {
+	at  time.Time
+	via Currency
+}
+
+// On computes the conversion using the historical rates for the given day
+// instead of the latest rates
+func On(t time.Time) ConvertOption {
+	return func(cc *convertConfig) {
+		cc.at = t
+	}
+}
+
+// Via computes the conversion through an intermediate base currency. This is
+// needed when the provider's own base is fixed (fixer.io's free plan only
+// ever quotes rates against EUR) and neither from nor to is that currency.
+func Via(base Currency) ConvertOption {
+	return func(cc *convertConfig) {
+		cc.via = base
+	}
+}
+
+// ConversionQuery is what was asked to be converted
+type ConversionQuery struct {
+	From   Currency
+	To     Currency
+	Amount float64
+}
+
+// ConversionInfo is the rate a Conversion used, and when it applied
+type ConversionInfo struct {
+	Rate      float64
+	Timestamp time.Time
+}
+
+// Conversion is the result of a Client.Convert call
+type Conversion struct {
+	Query  ConversionQuery
+	Info   ConversionInfo
+	Result Money
+}
+
+// Convert amount of from into to, at the latest rates unless On is given
+func (c *Client) Convert(ctx context.Context, from, to Currency, amount float64, opts ...ConvertOption) (*Conversion, error) {
+	return Convert(ctx, c, from, to, amount, opts...)
+}
+
+// Convert amount of from into to using e's Latest/At rates, at the latest
+// rates unless On is given. It is the shared implementation behind
+// Client.Convert and every fixer/providers backend's Convert, so the
+// cross-rate logic only lives in one place.
+func Convert(ctx context.Context, e Exchanger, from, to Currency, amount float64, opts ...ConvertOption) (*Conversion, error) {
+	cc := &convertConfig{via: EUR}
+
+	for _, o := range opts {
+		o(cc)
+	}
+
+	if from == to {
+		timestamp := cc.at
+		if timestamp.IsZero() {
+			timestamp = time.Now()
+		}
+
+		return &Conversion{
+			Query:  ConversionQuery{From: from, To: to, Amount: amount},
+			Info:   ConversionInfo{Rate: 1, Timestamp: timestamp},
+			Result: NewMoney(to, amount),
+		}, nil
+	}
+
+	resp, err := ratesFor(ctx, e, cc, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := crossRate(resp.Rates, resp.Base, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Conversion{
+		Query:  ConversionQuery{From: from, To: to, Amount: amount},
+		Info:   ConversionInfo{Rate: rate, Timestamp: resp.Date.Time},
+		Result: NewMoney(to, amount*rate),
+	}, nil
+}
+
+func ratesFor(ctx context.Context, e Exchanger, cc *convertConfig, from, to Currency) (*Response, error) {
+	attrs := []url.Values{Base(cc.via), Symbols(from, to)}
+
+	if cc.at.IsZero() {
+		return e.Latest(ctx, attrs...)
+	}
+
+	return e.At(ctx, cc.at, attrs...)
+}
+
+// crossRate returns the rate to multiply an amount of from by to get to,
+// given rates quoted against base.
+func crossRate(rates Rates, base, from, to Currency) (float64, error) {
+	if from == to {
+		return 1, nil
+	}
+
+	fromRate := 1.0
+
+	if from != base {
+		r, ok := rates[from]
+		if !ok {
+			return 0, NewError("fixer: no rate available for " + string(from))
+		}
+
+		fromRate = r
+	}
+
+	toRate := 1.0
+
+	if to != base {
+		r, ok := rates[to]
+		if !ok {
+			return 0, NewError("fixer: no rate available for " + string(to))
+		}
+
+		toRate = r
+	}
+
+	return toRate / fromRate, nil
+}
@@ -0,0 +1,139 @@
+package fixer
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func testServerAndClientForConvert() (*httptest.Server, *Client) {
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			enc := json.NewEncoder(w)
+
+			w.Header().Set("Content-Type", "application/json")
+
+			switch r.URL.String() {
+			case "/latest?base=EUR&symbols=SEK%2CUSD":
+				enc.Encode(map[string]interface{}{
+					"base": EUR,
+					"date": "2024-01-02",
+					"rates": Rates{
+						SEK: 11.2,
+						USD: 1.12,
+					},
+				})
+			case "/2012-03-28?base=EUR&symbols=SEK%2CUSD":
+				enc.Encode(map[string]interface{}{
+					"base": EUR,
+					"date": "2012-03-28",
+					"rates": Rates{
+						SEK: 8.9,
+						USD: 1.33,
+					},
+				})
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+	return ts, NewClient(BaseURL(ts.URL))
+}
+
+func TestConvert(t *testing.T) {
+	ts, c := testServerAndClientForConvert()
+	defer ts.Close()
+
+	t.Run("latest", func(t *testing.T) {
+		conv, err := c.Convert(context.Background(), USD, SEK, 10)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got, want := conv.Result, NewMoney(SEK, 10*11.2/1.12); got != want {
+			t.Fatalf("conv.Result = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("On", func(t *testing.T) {
+		date := time.Date(2012, 3, 28, 0, 0, 0, 0, time.UTC)
+
+		conv, err := c.Convert(context.Background(), USD, SEK, 10, On(date))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got, want := conv.Result, NewMoney(SEK, 10*8.9/1.33); got != want {
+			t.Fatalf("conv.Result = %v, want %v", got, want)
+		}
+
+		if got, want := conv.Info.Timestamp, date; !got.Equal(want) {
+			t.Fatalf("conv.Info.Timestamp = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("same currency", func(t *testing.T) {
+		before := time.Now()
+
+		conv, err := c.Convert(context.Background(), SEK, SEK, 42)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got, want := conv.Result, NewMoney(SEK, 42); got != want {
+			t.Fatalf("conv.Result = %v, want %v", got, want)
+		}
+
+		if conv.Info.Timestamp.Before(before) {
+			t.Fatalf("conv.Info.Timestamp = %v, want a time at or after %v", conv.Info.Timestamp, before)
+		}
+	})
+
+	t.Run("same currency, On", func(t *testing.T) {
+		date := time.Date(2012, 3, 28, 0, 0, 0, 0, time.UTC)
+
+		conv, err := c.Convert(context.Background(), SEK, SEK, 42, On(date))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got, want := conv.Info.Timestamp, date; !got.Equal(want) {
+			t.Fatalf("conv.Info.Timestamp = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestCrossRate(t *testing.T) {
+	rates := Rates{SEK: 11.2, USD: 1.12}
+
+	for _, tt := range []struct {
+		from, to Currency
+		want     float64
+	}{
+		{EUR, SEK, 11.2},
+		{SEK, EUR, 1 / 11.2},
+		{USD, SEK, 11.2 / 1.12},
+		{USD, USD, 1},
+	} {
+		got, err := crossRate(rates, EUR, tt.from, tt.to)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// Rates pass through two independent float64 divisions, so compare
+		// with a small tolerance rather than exact equality.
+		if math.Abs(got-tt.want) > 1e-9 {
+			t.Fatalf("crossRate(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestCrossRateMissingRate(t *testing.T) {
+	if _, err := crossRate(Rates{}, EUR, USD, SEK); err == nil {
+		t.Fatal("expected an error for a missing rate")
+	}
+}
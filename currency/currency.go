@@ -0,0 +1,36 @@
+// Package currency is a registry of ISO 4217 currency metadata (plus a
+// handful of widely used non-ISO codes: BTC, and the metals XAU/XAG), used
+// to validate currency codes before they're sent to a Foreign exchange
+// rates API.
+package currency
+
+// Metadata describes a single currency. Numeric, Symbol, Countries and Name
+// are only populated for the currencies table.go documents as having a Name;
+// the rest still validate, they just carry the zero value for those fields.
+type Metadata struct {
+	Code       string
+	Numeric    string
+	Symbol     string
+	MinorUnits int
+	Name       string
+	Countries  []string
+	IsCrypto   bool
+}
+
+// Lookup returns the Metadata for code, and whether it is known
+func Lookup(code string) (Metadata, bool) {
+	m, ok := registry[code]
+
+	return m, ok
+}
+
+// All returns the Metadata for every currency in the registry
+func All() []Metadata {
+	all := make([]Metadata, 0, len(registry))
+
+	for _, m := range registry {
+		all = append(all, m)
+	}
+
+	return all
+}
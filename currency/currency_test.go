@@ -0,0 +1,48 @@
+package currency
+
+import "testing"
+
+func TestLookup(t *testing.T) {
+	t.Run("known", func(t *testing.T) {
+		m, ok := Lookup("JPY")
+		if !ok {
+			t.Fatal("Lookup(\"JPY\") = false, want true")
+		}
+
+		if got, want := m.MinorUnits, 0; got != want {
+			t.Fatalf("m.MinorUnits = %d, want %d", got, want)
+		}
+
+		if got, want := m.Numeric, "392"; got != want {
+			t.Fatalf("m.Numeric = %q, want %q", got, want)
+		}
+
+		if got, want := m.Symbol, "¥"; got != want {
+			t.Fatalf("m.Symbol = %q, want %q", got, want)
+		}
+
+		if got, want := len(m.Countries), 1; got != want {
+			t.Fatalf("len(m.Countries) = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		if _, ok := Lookup("XXX"); ok {
+			t.Fatal("Lookup(\"XXX\") = true, want false")
+		}
+	})
+}
+
+func TestAll(t *testing.T) {
+	all := All()
+
+	if len(all) == 0 {
+		t.Fatal("All() returned no currencies")
+	}
+
+	for _, m := range all {
+		if m.Code == "" {
+			t.Fatal("All() returned a Metadata with an empty Code")
+		}
+	}
+}
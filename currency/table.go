@@ -0,0 +1,176 @@
+package currency
+
+// registry is seeded from the ISO 4217 currency list, plus BTC and the
+// metals XAU/XAG. Name (and, where it applies, Numeric, Symbol and
+// Countries) is only filled in for the most commonly traded currencies so
+// far; the rest still validate, they just have the zero value for those
+// fields.
+var registry = map[string]Metadata{
+	"AED": {Code: "AED", Numeric: "784", Symbol: "د.إ", MinorUnits: 2, Name: "UAE Dirham", Countries: []string{"United Arab Emirates"}, IsCrypto: false},
+	"AFN": {Code: "AFN", MinorUnits: 2, Name: "", IsCrypto: false},
+	"ALL": {Code: "ALL", MinorUnits: 2, Name: "", IsCrypto: false},
+	"AMD": {Code: "AMD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"ANG": {Code: "ANG", MinorUnits: 2, Name: "", IsCrypto: false},
+	"AOA": {Code: "AOA", MinorUnits: 2, Name: "", IsCrypto: false},
+	"ARS": {Code: "ARS", MinorUnits: 2, Name: "", IsCrypto: false},
+	"AUD": {Code: "AUD", Numeric: "036", Symbol: "$", MinorUnits: 2, Name: "Australian Dollar", Countries: []string{"Australia"}, IsCrypto: false},
+	"AWG": {Code: "AWG", MinorUnits: 2, Name: "", IsCrypto: false},
+	"AZN": {Code: "AZN", MinorUnits: 2, Name: "", IsCrypto: false},
+	"BAM": {Code: "BAM", MinorUnits: 2, Name: "", IsCrypto: false},
+	"BBD": {Code: "BBD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"BDT": {Code: "BDT", MinorUnits: 2, Name: "", IsCrypto: false},
+	"BGN": {Code: "BGN", MinorUnits: 2, Name: "", IsCrypto: false},
+	"BHD": {Code: "BHD", MinorUnits: 3, Name: "", IsCrypto: false},
+	"BIF": {Code: "BIF", MinorUnits: 0, Name: "", IsCrypto: false},
+	"BMD": {Code: "BMD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"BND": {Code: "BND", MinorUnits: 2, Name: "", IsCrypto: false},
+	"BOB": {Code: "BOB", MinorUnits: 2, Name: "", IsCrypto: false},
+	"BRL": {Code: "BRL", Numeric: "986", Symbol: "R$", MinorUnits: 2, Name: "Brazilian Real", Countries: []string{"Brazil"}, IsCrypto: false},
+	"BSD": {Code: "BSD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"BTC": {Code: "BTC", Symbol: "₿", MinorUnits: 2, Name: "Bitcoin", IsCrypto: true},
+	"BTN": {Code: "BTN", MinorUnits: 2, Name: "", IsCrypto: false},
+	"BWP": {Code: "BWP", MinorUnits: 2, Name: "", IsCrypto: false},
+	"BYN": {Code: "BYN", MinorUnits: 2, Name: "", IsCrypto: false},
+	"BYR": {Code: "BYR", MinorUnits: 2, Name: "", IsCrypto: false},
+	"BZD": {Code: "BZD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"CAD": {Code: "CAD", Numeric: "124", Symbol: "$", MinorUnits: 2, Name: "Canadian Dollar", Countries: []string{"Canada"}, IsCrypto: false},
+	"CDF": {Code: "CDF", MinorUnits: 2, Name: "", IsCrypto: false},
+	"CHF": {Code: "CHF", Numeric: "756", Symbol: "Fr", MinorUnits: 2, Name: "Swiss Franc", Countries: []string{"Switzerland", "Liechtenstein"}, IsCrypto: false},
+	"CLF": {Code: "CLF", MinorUnits: 2, Name: "", IsCrypto: false},
+	"CLP": {Code: "CLP", MinorUnits: 0, Name: "", IsCrypto: false},
+	"CNY": {Code: "CNY", Numeric: "156", Symbol: "¥", MinorUnits: 2, Name: "Chinese Yuan", Countries: []string{"China"}, IsCrypto: false},
+	"COP": {Code: "COP", MinorUnits: 2, Name: "", IsCrypto: false},
+	"CRC": {Code: "CRC", MinorUnits: 2, Name: "", IsCrypto: false},
+	"CUC": {Code: "CUC", MinorUnits: 2, Name: "", IsCrypto: false},
+	"CUP": {Code: "CUP", MinorUnits: 2, Name: "", IsCrypto: false},
+	"CVE": {Code: "CVE", MinorUnits: 2, Name: "", IsCrypto: false},
+	"CZK": {Code: "CZK", MinorUnits: 2, Name: "", IsCrypto: false},
+	"DJF": {Code: "DJF", MinorUnits: 0, Name: "", IsCrypto: false},
+	"DKK": {Code: "DKK", Numeric: "208", Symbol: "kr", MinorUnits: 2, Name: "Danish Krone", Countries: []string{"Denmark", "Greenland", "Faroe Islands"}, IsCrypto: false},
+	"DOP": {Code: "DOP", MinorUnits: 2, Name: "", IsCrypto: false},
+	"DZD": {Code: "DZD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"EGP": {Code: "EGP", MinorUnits: 2, Name: "", IsCrypto: false},
+	"ERN": {Code: "ERN", MinorUnits: 2, Name: "", IsCrypto: false},
+	"ETB": {Code: "ETB", MinorUnits: 2, Name: "", IsCrypto: false},
+	"EUR": {Code: "EUR", Numeric: "978", Symbol: "€", MinorUnits: 2, Name: "Euro", Countries: []string{"Germany", "France", "Italy", "Spain", "Netherlands", "Ireland", "Finland", "Portugal", "Greece", "Austria", "Belgium", "Luxembourg"}, IsCrypto: false},
+	"FJD": {Code: "FJD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"FKP": {Code: "FKP", MinorUnits: 2, Name: "", IsCrypto: false},
+	"GBP": {Code: "GBP", Numeric: "826", Symbol: "£", MinorUnits: 2, Name: "British Pound", Countries: []string{"United Kingdom"}, IsCrypto: false},
+	"GEL": {Code: "GEL", MinorUnits: 2, Name: "", IsCrypto: false},
+	"GGP": {Code: "GGP", MinorUnits: 2, Name: "", IsCrypto: false},
+	"GHS": {Code: "GHS", MinorUnits: 2, Name: "", IsCrypto: false},
+	"GIP": {Code: "GIP", MinorUnits: 2, Name: "", IsCrypto: false},
+	"GMD": {Code: "GMD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"GNF": {Code: "GNF", MinorUnits: 0, Name: "", IsCrypto: false},
+	"GTQ": {Code: "GTQ", MinorUnits: 2, Name: "", IsCrypto: false},
+	"GYD": {Code: "GYD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"HKD": {Code: "HKD", Numeric: "344", Symbol: "$", MinorUnits: 2, Name: "Hong Kong Dollar", Countries: []string{"Hong Kong"}, IsCrypto: false},
+	"HNL": {Code: "HNL", MinorUnits: 2, Name: "", IsCrypto: false},
+	"HRK": {Code: "HRK", MinorUnits: 2, Name: "", IsCrypto: false},
+	"HTG": {Code: "HTG", MinorUnits: 2, Name: "", IsCrypto: false},
+	"HUF": {Code: "HUF", MinorUnits: 2, Name: "", IsCrypto: false},
+	"IDR": {Code: "IDR", Numeric: "360", Symbol: "Rp", MinorUnits: 2, Name: "Indonesian Rupiah", Countries: []string{"Indonesia"}, IsCrypto: false},
+	"ILS": {Code: "ILS", Numeric: "376", Symbol: "₪", MinorUnits: 2, Name: "Israeli New Shekel", Countries: []string{"Israel"}, IsCrypto: false},
+	"IMP": {Code: "IMP", MinorUnits: 2, Name: "", IsCrypto: false},
+	"INR": {Code: "INR", Numeric: "356", Symbol: "₹", MinorUnits: 2, Name: "Indian Rupee", Countries: []string{"India"}, IsCrypto: false},
+	"IQD": {Code: "IQD", MinorUnits: 3, Name: "", IsCrypto: false},
+	"IRR": {Code: "IRR", MinorUnits: 2, Name: "", IsCrypto: false},
+	"ISK": {Code: "ISK", MinorUnits: 0, Name: "", IsCrypto: false},
+	"JEP": {Code: "JEP", MinorUnits: 2, Name: "", IsCrypto: false},
+	"JMD": {Code: "JMD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"JOD": {Code: "JOD", MinorUnits: 3, Name: "", IsCrypto: false},
+	"JPY": {Code: "JPY", Numeric: "392", Symbol: "¥", MinorUnits: 0, Name: "Japanese Yen", Countries: []string{"Japan"}, IsCrypto: false},
+	"KES": {Code: "KES", MinorUnits: 2, Name: "", IsCrypto: false},
+	"KGS": {Code: "KGS", MinorUnits: 2, Name: "", IsCrypto: false},
+	"KHR": {Code: "KHR", MinorUnits: 2, Name: "", IsCrypto: false},
+	"KMF": {Code: "KMF", MinorUnits: 0, Name: "", IsCrypto: false},
+	"KPW": {Code: "KPW", MinorUnits: 2, Name: "", IsCrypto: false},
+	"KRW": {Code: "KRW", Numeric: "410", Symbol: "₩", MinorUnits: 0, Name: "South Korean Won", Countries: []string{"South Korea"}, IsCrypto: false},
+	"KWD": {Code: "KWD", MinorUnits: 3, Name: "", IsCrypto: false},
+	"KYD": {Code: "KYD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"KZT": {Code: "KZT", MinorUnits: 2, Name: "", IsCrypto: false},
+	"LAK": {Code: "LAK", MinorUnits: 2, Name: "", IsCrypto: false},
+	"LBP": {Code: "LBP", MinorUnits: 2, Name: "", IsCrypto: false},
+	"LKR": {Code: "LKR", MinorUnits: 2, Name: "", IsCrypto: false},
+	"LRD": {Code: "LRD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"LSL": {Code: "LSL", MinorUnits: 2, Name: "", IsCrypto: false},
+	"LTL": {Code: "LTL", MinorUnits: 2, Name: "", IsCrypto: false},
+	"LVL": {Code: "LVL", MinorUnits: 2, Name: "", IsCrypto: false},
+	"LYD": {Code: "LYD", MinorUnits: 3, Name: "", IsCrypto: false},
+	"MAD": {Code: "MAD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"MDL": {Code: "MDL", MinorUnits: 2, Name: "", IsCrypto: false},
+	"MGA": {Code: "MGA", MinorUnits: 2, Name: "", IsCrypto: false},
+	"MKD": {Code: "MKD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"MMK": {Code: "MMK", MinorUnits: 2, Name: "", IsCrypto: false},
+	"MNT": {Code: "MNT", MinorUnits: 2, Name: "", IsCrypto: false},
+	"MOP": {Code: "MOP", MinorUnits: 2, Name: "", IsCrypto: false},
+	"MRO": {Code: "MRO", MinorUnits: 2, Name: "", IsCrypto: false},
+	"MUR": {Code: "MUR", MinorUnits: 2, Name: "", IsCrypto: false},
+	"MVR": {Code: "MVR", MinorUnits: 2, Name: "", IsCrypto: false},
+	"MWK": {Code: "MWK", MinorUnits: 2, Name: "", IsCrypto: false},
+	"MXN": {Code: "MXN", Numeric: "484", Symbol: "$", MinorUnits: 2, Name: "Mexican Peso", Countries: []string{"Mexico"}, IsCrypto: false},
+	"MYR": {Code: "MYR", MinorUnits: 2, Name: "", IsCrypto: false},
+	"MZN": {Code: "MZN", MinorUnits: 2, Name: "", IsCrypto: false},
+	"NAD": {Code: "NAD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"NGN": {Code: "NGN", MinorUnits: 2, Name: "", IsCrypto: false},
+	"NIO": {Code: "NIO", MinorUnits: 2, Name: "", IsCrypto: false},
+	"NOK": {Code: "NOK", Numeric: "578", Symbol: "kr", MinorUnits: 2, Name: "Norwegian Krone", Countries: []string{"Norway"}, IsCrypto: false},
+	"NPR": {Code: "NPR", MinorUnits: 2, Name: "", IsCrypto: false},
+	"NZD": {Code: "NZD", Numeric: "554", Symbol: "$", MinorUnits: 2, Name: "New Zealand Dollar", Countries: []string{"New Zealand"}, IsCrypto: false},
+	"OMR": {Code: "OMR", MinorUnits: 3, Name: "", IsCrypto: false},
+	"PAB": {Code: "PAB", MinorUnits: 2, Name: "", IsCrypto: false},
+	"PEN": {Code: "PEN", MinorUnits: 2, Name: "", IsCrypto: false},
+	"PGK": {Code: "PGK", MinorUnits: 2, Name: "", IsCrypto: false},
+	"PHP": {Code: "PHP", MinorUnits: 2, Name: "", IsCrypto: false},
+	"PKR": {Code: "PKR", MinorUnits: 2, Name: "", IsCrypto: false},
+	"PLN": {Code: "PLN", Numeric: "985", Symbol: "zł", MinorUnits: 2, Name: "Polish Zloty", Countries: []string{"Poland"}, IsCrypto: false},
+	"PYG": {Code: "PYG", MinorUnits: 0, Name: "", IsCrypto: false},
+	"QAR": {Code: "QAR", MinorUnits: 2, Name: "", IsCrypto: false},
+	"RON": {Code: "RON", MinorUnits: 2, Name: "", IsCrypto: false},
+	"RSD": {Code: "RSD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"RUB": {Code: "RUB", Numeric: "643", Symbol: "₽", MinorUnits: 2, Name: "Russian Ruble", Countries: []string{"Russia"}, IsCrypto: false},
+	"RWF": {Code: "RWF", MinorUnits: 0, Name: "", IsCrypto: false},
+	"SAR": {Code: "SAR", Numeric: "682", Symbol: "﷼", MinorUnits: 2, Name: "Saudi Riyal", Countries: []string{"Saudi Arabia"}, IsCrypto: false},
+	"SBD": {Code: "SBD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"SCR": {Code: "SCR", MinorUnits: 2, Name: "", IsCrypto: false},
+	"SDG": {Code: "SDG", MinorUnits: 2, Name: "", IsCrypto: false},
+	"SEK": {Code: "SEK", Numeric: "752", Symbol: "kr", MinorUnits: 2, Name: "Swedish Krona", Countries: []string{"Sweden"}, IsCrypto: false},
+	"SGD": {Code: "SGD", Numeric: "702", Symbol: "$", MinorUnits: 2, Name: "Singapore Dollar", Countries: []string{"Singapore"}, IsCrypto: false},
+	"SHP": {Code: "SHP", MinorUnits: 2, Name: "", IsCrypto: false},
+	"SLL": {Code: "SLL", MinorUnits: 2, Name: "", IsCrypto: false},
+	"SOS": {Code: "SOS", MinorUnits: 2, Name: "", IsCrypto: false},
+	"SRD": {Code: "SRD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"STD": {Code: "STD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"SVC": {Code: "SVC", MinorUnits: 2, Name: "", IsCrypto: false},
+	"SYP": {Code: "SYP", MinorUnits: 2, Name: "", IsCrypto: false},
+	"SZL": {Code: "SZL", MinorUnits: 2, Name: "", IsCrypto: false},
+	"THB": {Code: "THB", Numeric: "764", Symbol: "฿", MinorUnits: 2, Name: "Thai Baht", Countries: []string{"Thailand"}, IsCrypto: false},
+	"TJS": {Code: "TJS", MinorUnits: 2, Name: "", IsCrypto: false},
+	"TMT": {Code: "TMT", MinorUnits: 2, Name: "", IsCrypto: false},
+	"TND": {Code: "TND", MinorUnits: 3, Name: "", IsCrypto: false},
+	"TOP": {Code: "TOP", MinorUnits: 2, Name: "", IsCrypto: false},
+	"TRY": {Code: "TRY", Numeric: "949", Symbol: "₺", MinorUnits: 2, Name: "Turkish Lira", Countries: []string{"Turkey"}, IsCrypto: false},
+	"TTD": {Code: "TTD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"TWD": {Code: "TWD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"TZS": {Code: "TZS", MinorUnits: 2, Name: "", IsCrypto: false},
+	"UAH": {Code: "UAH", MinorUnits: 2, Name: "", IsCrypto: false},
+	"UGX": {Code: "UGX", MinorUnits: 0, Name: "", IsCrypto: false},
+	"USD": {Code: "USD", Numeric: "840", Symbol: "$", MinorUnits: 2, Name: "US Dollar", Countries: []string{"United States", "Ecuador", "El Salvador", "Panama"}, IsCrypto: false},
+	"UYU": {Code: "UYU", MinorUnits: 2, Name: "", IsCrypto: false},
+	"UZS": {Code: "UZS", MinorUnits: 2, Name: "", IsCrypto: false},
+	"VEF": {Code: "VEF", MinorUnits: 2, Name: "", IsCrypto: false},
+	"VND": {Code: "VND", MinorUnits: 0, Name: "", IsCrypto: false},
+	"VUV": {Code: "VUV", MinorUnits: 0, Name: "", IsCrypto: false},
+	"WST": {Code: "WST", MinorUnits: 2, Name: "", IsCrypto: false},
+	"XAF": {Code: "XAF", MinorUnits: 0, Name: "", IsCrypto: false},
+	"XAG": {Code: "XAG", Numeric: "961", Symbol: "oz t", MinorUnits: 2, Name: "Silver (troy ounce)", IsCrypto: false},
+	"XAU": {Code: "XAU", Numeric: "959", Symbol: "oz t", MinorUnits: 2, Name: "Gold (troy ounce)", IsCrypto: false},
+	"XCD": {Code: "XCD", MinorUnits: 2, Name: "", IsCrypto: false},
+	"XDR": {Code: "XDR", Numeric: "960", MinorUnits: 2, Name: "IMF Special Drawing Rights", IsCrypto: false},
+	"XOF": {Code: "XOF", MinorUnits: 0, Name: "", IsCrypto: false},
+	"XPF": {Code: "XPF", MinorUnits: 0, Name: "", IsCrypto: false},
+	"YER": {Code: "YER", MinorUnits: 2, Name: "", IsCrypto: false},
+	"ZAR": {Code: "ZAR", Numeric: "710", Symbol: "R", MinorUnits: 2, Name: "South African Rand", Countries: []string{"South Africa"}, IsCrypto: false},
+	"ZMK": {Code: "ZMK", MinorUnits: 2, Name: "", IsCrypto: false},
+	"ZMW": {Code: "ZMW", MinorUnits: 2, Name: "", IsCrypto: false},
+	"ZWL": {Code: "ZWL", MinorUnits: 2, Name: "", IsCrypto: false}}
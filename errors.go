@@ -1,30 +1,133 @@
 package fixer
 
-import "net/http"
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
 
-// NewError creates a new Error
+// NewError creates a new Error carrying only a message
 func NewError(msg string) *Error {
 	return &Error{msg: msg}
 }
 
-// Error type for Fixer API requests
+// Error is returned for a failed Fixer API request. When the server replies
+// with its {"success":false,"error":{...}} JSON envelope, Code, Type and
+// Info are populated from it, along with the HTTPStatus and URL of the
+// request that failed; otherwise the Error behaves like a plain message.
 type Error struct {
 	msg string
+
+	Code       int
+	Type       string
+	Info       string
+	HTTPStatus int
+	URL        string
 }
 
 // Error message
 func (e *Error) Error() string {
+	if e.Type != "" {
+		return fmt.Sprintf("fixer: %s (code %d): %s", e.Type, e.Code, e.Info)
+	}
+
 	return e.msg
 }
 
+// Is makes Error matchable with errors.Is: two Errors decoded from a
+// provider's error envelope are considered equal if they carry the same
+// non-zero Code, regardless of Info (which often includes request-specific
+// detail).
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+
+	if e == t {
+		return true
+	}
+
+	return e.Code != 0 && e.Code == t.Code
+}
+
 // Errors
 var (
 	ErrNilResponse         = NewError("Unexpected nil response")
 	ErrUnexpectedStatus    = NewError("Unexpected status")
 	ErrNotFound            = NewError(http.StatusText(http.StatusNotFound))
 	ErrUnprocessableEntity = NewError(http.StatusText(http.StatusUnprocessableEntity))
+
+	// ErrCurrencyNotSupported is returned by Client.Base and Client.Symbols,
+	// when the Client was created with StrictCurrencies(true), for a
+	// currency code that isn't in the fixer/currency registry
+	ErrCurrencyNotSupported = NewError("currency not supported")
+
+	// Errors mapped from fixer.io's documented numeric error codes
+	ErrInvalidAccessKey         = &Error{msg: "invalid access key", Code: 101, Type: "invalid_access_key"}
+	ErrInactiveUser             = &Error{msg: "inactive user", Code: 102, Type: "inactive_user"}
+	ErrUsageLimitReached        = &Error{msg: "usage limit reached", Code: 104, Type: "usage_limit_reached"}
+	ErrFunctionAccessRestricted = &Error{msg: "function access restricted", Code: 105, Type: "function_access_restricted"}
+	ErrHTTPSAccessRestricted    = &Error{msg: "https access restricted", Code: 106, Type: "https_access_restricted"}
+	ErrInvalidBaseCurrency      = &Error{msg: "invalid base currency", Code: 201, Type: "invalid_base_currency"}
+	ErrInvalidCurrencyCodes     = &Error{msg: "invalid currency codes", Code: 202, Type: "invalid_currency_codes"}
+	ErrNoRatesAvailable         = &Error{msg: "no rates available", Code: 301, Type: "no_rates_available"}
+	ErrInvalidTimeframe         = &Error{msg: "invalid timeframe", Code: 302, Type: "invalid_timeframe"}
+	ErrTimeframeTooLong         = &Error{msg: "timeframe too long", Code: 303, Type: "timeframe_too_long"}
 )
 
+// errorsByCode maps a provider error code onto the sentinel Error callers
+// can compare against with errors.Is
+var errorsByCode = map[int]*Error{
+	ErrInvalidAccessKey.Code:         ErrInvalidAccessKey,
+	ErrInactiveUser.Code:             ErrInactiveUser,
+	ErrUsageLimitReached.Code:        ErrUsageLimitReached,
+	ErrFunctionAccessRestricted.Code: ErrFunctionAccessRestricted,
+	ErrHTTPSAccessRestricted.Code:    ErrHTTPSAccessRestricted,
+	ErrInvalidBaseCurrency.Code:      ErrInvalidBaseCurrency,
+	ErrInvalidCurrencyCodes.Code:     ErrInvalidCurrencyCodes,
+	ErrNoRatesAvailable.Code:         ErrNoRatesAvailable,
+	ErrInvalidTimeframe.Code:         ErrInvalidTimeframe,
+	ErrTimeframeTooLong.Code:         ErrTimeframeTooLong,
+}
+
+// errorEnvelope is the {"success":false,"error":{...}} shape a fixer.io
+// compatible API returns on failure
+type errorEnvelope struct {
+	Success bool `json:"success"`
+	Error   struct {
+		Code int    `json:"code"`
+		Type string `json:"type"`
+		Info string `json:"info"`
+	} `json:"error"`
+}
+
+// decodeError attempts to parse body as the provider's JSON error envelope,
+// returning nil when body isn't one (so the caller can fall back to
+// status-based mapping).
+func decodeError(body []byte, status int, rawurl string) *Error {
+	var env errorEnvelope
+
+	if err := json.Unmarshal(body, &env); err != nil || env.Success || env.Error.Code == 0 {
+		return nil
+	}
+
+	msg := env.Error.Info
+
+	if known, ok := errorsByCode[env.Error.Code]; ok {
+		msg = known.msg
+	}
+
+	return &Error{
+		msg:        msg,
+		Code:       env.Error.Code,
+		Type:       env.Error.Type,
+		Info:       env.Error.Info,
+		HTTPStatus: status,
+		URL:        rawurl,
+	}
+}
+
 func responseError(resp *http.Response) error {
 	if resp == nil {
 		return ErrNilResponse
@@ -1,6 +1,7 @@
 package fixer
 
 import (
+	"errors"
 	"net/http"
 	"testing"
 )
@@ -29,3 +30,55 @@ func TestResponseError(t *testing.T) {
 		}
 	}
 }
+
+func TestDecodeError(t *testing.T) {
+	t.Run("known code", func(t *testing.T) {
+		body := []byte(`{"success":false,"error":{"code":104,"type":"usage_limit_reached","info":"Your monthly usage limit has been reached"}}`)
+
+		err := decodeError(body, http.StatusTooManyRequests, "https://data.fixer.io/api/latest")
+		if err == nil {
+			t.Fatal("expected a decoded error")
+		}
+
+		if !errors.Is(err, ErrUsageLimitReached) {
+			t.Fatalf("errors.Is(err, ErrUsageLimitReached) = false for %v", err)
+		}
+
+		if got, want := err.HTTPStatus, http.StatusTooManyRequests; got != want {
+			t.Fatalf("err.HTTPStatus = %d, want %d", got, want)
+		}
+
+		if got, want := err.Info, "Your monthly usage limit has been reached"; got != want {
+			t.Fatalf("err.Info = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("not an envelope", func(t *testing.T) {
+		if err := decodeError([]byte(`NOT JSON`), 500, ""); err != nil {
+			t.Fatalf("decodeError(...) = %v, want nil", err)
+		}
+	})
+
+	t.Run("success true", func(t *testing.T) {
+		if err := decodeError([]byte(`{"success":true}`), 200, ""); err != nil {
+			t.Fatalf("decodeError(...) = %v, want nil", err)
+		}
+	})
+}
+
+func TestErrorIs(t *testing.T) {
+	a := decodeError([]byte(`{"success":false,"error":{"code":104,"type":"usage_limit_reached","info":"a"}}`), 429, "")
+	b := decodeError([]byte(`{"success":false,"error":{"code":104,"type":"usage_limit_reached","info":"b"}}`), 429, "")
+
+	if !errors.Is(a, b) {
+		t.Fatalf("errors.Is(a, b) = false, want true for errors sharing code %d", a.Code)
+	}
+
+	if errors.Is(a, ErrInvalidAccessKey) {
+		t.Fatal("errors.Is(a, ErrInvalidAccessKey) = true, want false")
+	}
+
+	if !errors.Is(ErrNotFound, ErrNotFound) {
+		t.Fatal("errors.Is(ErrNotFound, ErrNotFound) = false, want true")
+	}
+}
@@ -0,0 +1,92 @@
+package fixer
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// Exchanger is implemented by anything that can serve the foreign exchange
+// rate data this package works with. *Client satisfies it against
+// api.fixer.io, and the fixer/providers packages ship drop-in
+// implementations for other backends, so callers can switch providers (or
+// fall back from one to another with Chain) without changing call sites.
+type Exchanger interface {
+	// Latest foreign exchange reference rates
+	Latest(ctx context.Context, attributes ...url.Values) (*Response, error)
+
+	// At returns historical rates for any day since 1999
+	At(ctx context.Context, t time.Time, attributes ...url.Values) (*Response, error)
+
+	// TimeSeries returns daily rates for the closed range [start, end]
+	TimeSeries(ctx context.Context, start, end time.Time, attributes ...url.Values) (*TimeSeriesResponse, error)
+
+	// Convert amount of from into to, at the latest rates unless On is given
+	Convert(ctx context.Context, from, to Currency, amount float64, opts ...ConvertOption) (*Conversion, error)
+}
+
+// Chain returns an Exchanger that tries each of the given exchangers in
+// order, returning the first response obtained without error. This lets an
+// application fall back from one provider to the next, for example from
+// fixer.io to Frankfurter when a quota is exhausted or a key is missing.
+func Chain(exchangers ...Exchanger) Exchanger {
+	return chain(exchangers)
+}
+
+type chain []Exchanger
+
+func (c chain) Latest(ctx context.Context, attributes ...url.Values) (*Response, error) {
+	var err error
+
+	for _, e := range c {
+		var resp *Response
+
+		if resp, err = e.Latest(ctx, attributes...); err == nil {
+			return resp, nil
+		}
+	}
+
+	return nil, err
+}
+
+func (c chain) At(ctx context.Context, t time.Time, attributes ...url.Values) (*Response, error) {
+	var err error
+
+	for _, e := range c {
+		var resp *Response
+
+		if resp, err = e.At(ctx, t, attributes...); err == nil {
+			return resp, nil
+		}
+	}
+
+	return nil, err
+}
+
+func (c chain) TimeSeries(ctx context.Context, start, end time.Time, attributes ...url.Values) (*TimeSeriesResponse, error) {
+	var err error
+
+	for _, e := range c {
+		var resp *TimeSeriesResponse
+
+		if resp, err = e.TimeSeries(ctx, start, end, attributes...); err == nil {
+			return resp, nil
+		}
+	}
+
+	return nil, err
+}
+
+func (c chain) Convert(ctx context.Context, from, to Currency, amount float64, opts ...ConvertOption) (*Conversion, error) {
+	var err error
+
+	for _, e := range c {
+		var conv *Conversion
+
+		if conv, err = e.Convert(ctx, from, to, amount, opts...); err == nil {
+			return conv, nil
+		}
+	}
+
+	return nil, err
+}
@@ -0,0 +1,99 @@
+package fixer
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+)
+
+type fakeExchanger struct {
+	resp   *Response
+	tsResp *TimeSeriesResponse
+	conv   *Conversion
+	err    error
+}
+
+func (f fakeExchanger) Latest(ctx context.Context, attributes ...url.Values) (*Response, error) {
+	return f.resp, f.err
+}
+
+func (f fakeExchanger) At(ctx context.Context, t time.Time, attributes ...url.Values) (*Response, error) {
+	return f.resp, f.err
+}
+
+func (f fakeExchanger) TimeSeries(ctx context.Context, start, end time.Time, attributes ...url.Values) (*TimeSeriesResponse, error) {
+	return f.tsResp, f.err
+}
+
+func (f fakeExchanger) Convert(ctx context.Context, from, to Currency, amount float64, opts ...ConvertOption) (*Conversion, error) {
+	return f.conv, f.err
+}
+
+func TestChain(t *testing.T) {
+	want := &Response{Base: SEK}
+
+	t.Run("first succeeds", func(t *testing.T) {
+		e := Chain(fakeExchanger{resp: want}, fakeExchanger{err: ErrUnexpectedStatus})
+
+		resp, err := e.Latest(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if resp != want {
+			t.Fatalf("resp = %v, want %v", resp, want)
+		}
+	})
+
+	t.Run("falls back", func(t *testing.T) {
+		e := Chain(fakeExchanger{err: ErrNotFound}, fakeExchanger{resp: want})
+
+		resp, err := e.At(context.Background(), time.Now())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if resp != want {
+			t.Fatalf("resp = %v, want %v", resp, want)
+		}
+	})
+
+	t.Run("all fail", func(t *testing.T) {
+		e := Chain(fakeExchanger{err: ErrNotFound}, fakeExchanger{err: ErrUnexpectedStatus})
+
+		if _, err := e.Latest(context.Background()); err != ErrUnexpectedStatus {
+			t.Fatalf("err = %v, want %v", err, ErrUnexpectedStatus)
+		}
+	})
+
+	t.Run("TimeSeries falls back", func(t *testing.T) {
+		wantTS := &TimeSeriesResponse{Base: SEK}
+
+		e := Chain(fakeExchanger{err: ErrNotFound}, fakeExchanger{tsResp: wantTS})
+
+		resp, err := e.TimeSeries(context.Background(), time.Now(), time.Now())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if resp != wantTS {
+			t.Fatalf("resp = %v, want %v", resp, wantTS)
+		}
+	})
+
+	t.Run("Convert falls back", func(t *testing.T) {
+		wantConv := &Conversion{Result: NewMoney(SEK, 10)}
+
+		e := Chain(fakeExchanger{err: ErrNotFound}, fakeExchanger{conv: wantConv})
+
+		conv, err := e.Convert(context.Background(), USD, SEK, 10)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if conv != wantConv {
+			t.Fatalf("conv = %v, want %v", conv, wantConv)
+		}
+	})
+}
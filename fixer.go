@@ -77,6 +77,13 @@ func (d *Date) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// MarshalJSON writes d in YYYY-MM-DD format, the same layout UnmarshalJSON
+// parses, so a Date round-trips through JSON instead of falling back to
+// time.Time's RFC 3339 encoding
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Format("2006-01-02"))
+}
+
 // Rates is the list of rates quoted against the base (EUR by default)
 type Rates map[Currency]float64
 
@@ -106,6 +113,19 @@ func (cs Currencies) String() string {
 	return strings.Join(symbols, ",")
 }
 
+// Filter returns the subset of cs for which pred returns true
+func (cs Currencies) Filter(pred func(Currency) bool) Currencies {
+	out := make(Currencies, 0, len(cs))
+
+	for _, c := range cs {
+		if pred(c) {
+			out = append(out, c)
+		}
+	}
+
+	return out
+}
+
 // Currency is the type used for ISO 4217 Currency codes
 type Currency string
 
@@ -30,6 +30,29 @@ func TestDateUnmarshalJSON(t *testing.T) {
 	}
 }
 
+func TestDateMarshalJSON(t *testing.T) {
+	d := Date{time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)}
+
+	b, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := string(b), `"2024-01-02"`; got != want {
+		t.Fatalf("json.Marshal(d) = %s, want %s", got, want)
+	}
+
+	var got Date
+
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !got.Equal(d.Time) {
+		t.Fatalf("round-tripped Date = %v, want %v", got, d)
+	}
+}
+
 func TestCurrenciesString(t *testing.T) {
 	for _, tt := range []struct {
 		cs   Currencies
@@ -0,0 +1,60 @@
+package fixer
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/peterhellberg/fixer/currency"
+)
+
+// MinorUnits returns the number of digits after the decimal point used for
+// fractional amounts of c, as defined by ISO 4217, looking it up in the
+// fixer/currency registry. Currencies that are not in the registry default
+// to 2.
+func MinorUnits(c Currency) int {
+	if m, ok := currency.Lookup(string(c)); ok {
+		return m.MinorUnits
+	}
+
+	return 2
+}
+
+// Money is an amount of a Currency stored as an integer number of minor
+// units (cents, öre, fils, …) so that adding and rounding amounts is
+// deterministic, unlike doing arithmetic directly on a float64.
+type Money struct {
+	Currency Currency
+	Units    int64
+}
+
+// NewMoney rounds amount to the Currency's minor units and returns a Money
+func NewMoney(c Currency, amount float64) Money {
+	return Money{
+		Currency: c,
+		Units:    int64(math.Round(amount * math.Pow10(MinorUnits(c)))),
+	}
+}
+
+// Float64 returns m as a floating point amount
+func (m Money) Float64() float64 {
+	return float64(m.Units) / math.Pow10(MinorUnits(m.Currency))
+}
+
+// Format renders m using the Currency's minor units, e.g. "123.40 SEK"
+func (m Money) Format() string {
+	return fmt.Sprintf("%.*f %s", MinorUnits(m.Currency), m.Float64(), m.Currency)
+}
+
+// Add returns m plus other. It panics if the two currencies differ.
+func (m Money) Add(other Money) Money {
+	if m.Currency != other.Currency {
+		panic(fmt.Sprintf("fixer: cannot add %s to %s", other.Currency, m.Currency))
+	}
+
+	return Money{Currency: m.Currency, Units: m.Units + other.Units}
+}
+
+// Rate returns m scaled by rate, rounded to the Currency's minor units
+func (m Money) Rate(rate float64) Money {
+	return NewMoney(m.Currency, m.Float64()*rate)
+}
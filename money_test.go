@@ -0,0 +1,79 @@
+package fixer
+
+import "testing"
+
+func TestMinorUnits(t *testing.T) {
+	for _, tt := range []struct {
+		c    Currency
+		want int
+	}{
+		{JPY, 0},
+		{KRW, 0},
+		{BHD, 3},
+		{KWD, 3},
+		{SEK, 2},
+		{USD, 2},
+		{BTC, 2},
+	} {
+		if got := MinorUnits(tt.c); got != tt.want {
+			t.Fatalf("MinorUnits(%s) = %d, want %d", tt.c, got, tt.want)
+		}
+	}
+}
+
+func TestNewMoney(t *testing.T) {
+	for _, tt := range []struct {
+		c      Currency
+		amount float64
+		want   int64
+	}{
+		{SEK, 123.4, 12340},
+		{JPY, 1500, 1500},
+		{BHD, 1.2345, 1235},
+	} {
+		if got := NewMoney(tt.c, tt.amount).Units; got != tt.want {
+			t.Fatalf("NewMoney(%s, %v).Units = %d, want %d", tt.c, tt.amount, got, tt.want)
+		}
+	}
+}
+
+func TestMoneyFormat(t *testing.T) {
+	for _, tt := range []struct {
+		m    Money
+		want string
+	}{
+		{NewMoney(SEK, 123.4), "123.40 SEK"},
+		{NewMoney(JPY, 1500), "1500 JPY"},
+		{NewMoney(BHD, 1.234), "1.234 BHD"},
+	} {
+		if got := tt.m.Format(); got != tt.want {
+			t.Fatalf("Format() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestMoneyAdd(t *testing.T) {
+	got := NewMoney(SEK, 10).Add(NewMoney(SEK, 2.5))
+
+	if want := NewMoney(SEK, 12.5); got != want {
+		t.Fatalf("Add() = %v, want %v", got, want)
+	}
+}
+
+func TestMoneyAddPanicsOnCurrencyMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected Add to panic on currency mismatch")
+		}
+	}()
+
+	NewMoney(SEK, 10).Add(NewMoney(USD, 10))
+}
+
+func TestMoneyRate(t *testing.T) {
+	got := NewMoney(SEK, 100).Rate(0.5)
+
+	if want := NewMoney(SEK, 50); got != want {
+		t.Fatalf("Rate(0.5) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,176 @@
+// Package currencyapi implements fixer.Exchanger against
+// https://currencyapi.com
+package currencyapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/peterhellberg/fixer"
+)
+
+// Provider is a fixer.Exchanger backed by api.currencyapi.com
+type Provider struct {
+	httpClient *http.Client
+	baseURL    string
+	apiKey     string
+}
+
+// New creates a Provider using the given API key
+func New(apiKey string, options ...func(*Provider)) *Provider {
+	p := &Provider{
+		httpClient: http.DefaultClient,
+		baseURL:    "https://api.currencyapi.com/v3",
+		apiKey:     apiKey,
+	}
+
+	for _, f := range options {
+		f(p)
+	}
+
+	return p
+}
+
+// HTTPClient changes the HTTP client to the provided *http.Client
+func HTTPClient(hc *http.Client) func(*Provider) {
+	return func(p *Provider) {
+		p.httpClient = hc
+	}
+}
+
+// BaseURL changes the base URL to the provided rawurl
+func BaseURL(rawurl string) func(*Provider) {
+	return func(p *Provider) {
+		p.baseURL = rawurl
+	}
+}
+
+// envelope is the {"meta":{...},"data":{...}} shape currencyapi.com returns
+// from both /latest and /historical
+type envelope struct {
+	Meta struct {
+		LastUpdatedAt string `json:"last_updated_at"`
+	} `json:"meta"`
+	Data map[fixer.Currency]struct {
+		Value float64 `json:"value"`
+	} `json:"data"`
+}
+
+// Latest foreign exchange reference rates
+func (p *Provider) Latest(ctx context.Context, attributes ...url.Values) (*fixer.Response, error) {
+	return p.get(ctx, "latest", "", attributes)
+}
+
+// At returns historical rates for the given day
+func (p *Provider) At(ctx context.Context, t time.Time, attributes ...url.Values) (*fixer.Response, error) {
+	return p.get(ctx, "historical", t.Format("2006-01-02"), attributes)
+}
+
+// TimeSeries returns daily rates for the closed range [start, end].
+// currencyapi.com has no range endpoint, so this fetches one day at a time.
+func (p *Provider) TimeSeries(ctx context.Context, start, end time.Time, attributes ...url.Values) (*fixer.TimeSeriesResponse, error) {
+	var base fixer.Currency
+
+	rates := map[fixer.Date]fixer.Rates{}
+
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		resp, err := p.At(ctx, d, attributes...)
+		if err != nil {
+			return nil, err
+		}
+
+		base = resp.Base
+		rates[resp.Date] = resp.Rates
+	}
+
+	return &fixer.TimeSeriesResponse{
+		Base:      base,
+		StartDate: fixer.Date{Time: start},
+		EndDate:   fixer.Date{Time: end},
+		Rates:     rates,
+	}, nil
+}
+
+func (p *Provider) get(ctx context.Context, path, date string, attributes []url.Values) (*fixer.Response, error) {
+	base := fixer.EUR
+
+	q := url.Values{}
+	q.Set("apikey", p.apiKey)
+
+	for _, a := range attributes {
+		if b := a.Get("base"); b != "" {
+			base = fixer.Currency(b)
+		}
+
+		if symbols := a.Get("symbols"); symbols != "" {
+			q.Set("currencies", symbols)
+		}
+	}
+
+	q.Set("base_currency", string(base))
+
+	if date != "" {
+		q.Set("date", date)
+	}
+
+	req, err := http.NewRequest("GET", p.baseURL+"/"+path+"?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+	req.Header.Add("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fixer.ErrUnexpectedStatus
+	}
+
+	var e envelope
+
+	if err := json.NewDecoder(resp.Body).Decode(&e); err != nil {
+		return nil, err
+	}
+
+	if date == "" {
+		date = time.Now().UTC().Format("2006-01-02")
+
+		if e.Meta.LastUpdatedAt != "" {
+			if t, err := time.Parse(time.RFC3339, e.Meta.LastUpdatedAt); err == nil {
+				date = t.Format("2006-01-02")
+			}
+		}
+	}
+
+	d, err := time.ParseInLocation("2006-01-02", date, time.UTC)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := fixer.Rates{}
+
+	for c, v := range e.Data {
+		rates[c] = v.Value
+	}
+
+	return &fixer.Response{
+		Base:  base,
+		Date:  fixer.Date{Time: d},
+		Rates: rates,
+	}, nil
+}
+
+// Convert amount of from into to, at the latest rates unless fixer.On is given
+func (p *Provider) Convert(ctx context.Context, from, to fixer.Currency, amount float64, opts ...fixer.ConvertOption) (*fixer.Conversion, error) {
+	return fixer.Convert(ctx, p, from, to, amount, opts...)
+}
+
+var _ fixer.Exchanger = (*Provider)(nil)
@@ -0,0 +1,63 @@
+package currencyapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/peterhellberg/fixer"
+)
+
+func testServerAndProvider() (*httptest.Server, *Provider) {
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+
+			switch {
+			case r.URL.Path == "/latest":
+				w.Write([]byte(`{"meta":{"last_updated_at":"2024-01-02T00:00:00Z"},"data":{"USD":{"code":"USD","value":1.09}}}`))
+			case r.URL.Path == "/historical":
+				w.Write([]byte(`{"meta":{"last_updated_at":"2012-03-28T00:00:00Z"},"data":{"USD":{"code":"USD","value":1.3}}}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+	return ts, New("key", BaseURL(ts.URL))
+}
+
+func TestProviderLatest(t *testing.T) {
+	ts, p := testServerAndProvider()
+	defer ts.Close()
+
+	resp, err := p.Latest(context.Background(), fixer.Symbols(fixer.USD))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := resp.Base, fixer.EUR; got != want {
+		t.Fatalf("resp.Base = %q, want %q", got, want)
+	}
+
+	if got, want := resp.Rates[fixer.USD], 1.09; got != want {
+		t.Fatalf("resp.Rates[USD] = %v, want %v", got, want)
+	}
+}
+
+func TestProviderAt(t *testing.T) {
+	ts, p := testServerAndProvider()
+	defer ts.Close()
+
+	date := time.Date(2012, 3, 28, 0, 0, 0, 0, time.UTC)
+
+	resp, err := p.At(context.Background(), date, fixer.Symbols(fixer.USD))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := resp.Date.Time, date; !got.Equal(want) {
+		t.Fatalf("resp.Date.Time = %v, want %v", got, want)
+	}
+}
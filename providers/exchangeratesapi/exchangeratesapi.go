@@ -0,0 +1,49 @@
+// Package exchangeratesapi implements fixer.Exchanger against
+// https://api.exchangeratesapi.io, which serves the same response shape as
+// fixer.io.
+package exchangeratesapi
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/peterhellberg/fixer"
+)
+
+// Provider is a fixer.Exchanger backed by api.exchangeratesapi.io
+type Provider struct {
+	client *fixer.Client
+}
+
+// New creates a Provider using the given access key
+func New(accessKey string, options ...func(*fixer.Client)) *Provider {
+	opts := append([]func(*fixer.Client){
+		fixer.BaseURL("https://api.exchangeratesapi.io"),
+		fixer.AccessKey(accessKey),
+	}, options...)
+
+	return &Provider{client: fixer.NewClient(opts...)}
+}
+
+// Latest foreign exchange reference rates
+func (p *Provider) Latest(ctx context.Context, attributes ...url.Values) (*fixer.Response, error) {
+	return p.client.Latest(ctx, attributes...)
+}
+
+// At returns historical rates for any day since 1999
+func (p *Provider) At(ctx context.Context, t time.Time, attributes ...url.Values) (*fixer.Response, error) {
+	return p.client.At(ctx, t, attributes...)
+}
+
+// TimeSeries returns daily rates for the closed range [start, end]
+func (p *Provider) TimeSeries(ctx context.Context, start, end time.Time, attributes ...url.Values) (*fixer.TimeSeriesResponse, error) {
+	return p.client.TimeSeries(ctx, start, end, attributes...)
+}
+
+// Convert amount of from into to, at the latest rates unless fixer.On is given
+func (p *Provider) Convert(ctx context.Context, from, to fixer.Currency, amount float64, opts ...fixer.ConvertOption) (*fixer.Conversion, error) {
+	return p.client.Convert(ctx, from, to, amount, opts...)
+}
+
+var _ fixer.Exchanger = (*Provider)(nil)
@@ -0,0 +1,81 @@
+package fixerio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/peterhellberg/fixer"
+)
+
+func testServerAndProvider() (*httptest.Server, *Provider) {
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+
+			if got, want := r.URL.Query().Get("access_key"), "key"; got != want {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			switch r.URL.Path {
+			case "/latest":
+				w.Write([]byte(`{"base":"EUR","date":"2024-01-02","rates":{"USD":1.09}}`))
+			case "/2012-03-28":
+				w.Write([]byte(`{"base":"EUR","date":"2012-03-28","rates":{"USD":1.3}}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+	p := New("key", fixer.BaseURL(ts.URL))
+
+	return ts, p
+}
+
+func TestProviderLatest(t *testing.T) {
+	ts, p := testServerAndProvider()
+	defer ts.Close()
+
+	resp, err := p.Latest(context.Background(), fixer.Symbols(fixer.USD))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := resp.Base, fixer.EUR; got != want {
+		t.Fatalf("resp.Base = %q, want %q", got, want)
+	}
+
+	if got, want := resp.Rates[fixer.USD], 1.09; got != want {
+		t.Fatalf("resp.Rates[USD] = %v, want %v", got, want)
+	}
+}
+
+func TestProviderAt(t *testing.T) {
+	ts, p := testServerAndProvider()
+	defer ts.Close()
+
+	date := time.Date(2012, 3, 28, 0, 0, 0, 0, time.UTC)
+
+	resp, err := p.At(context.Background(), date, fixer.Symbols(fixer.USD))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := resp.Date.Time, date; !got.Equal(want) {
+		t.Fatalf("resp.Date.Time = %v, want %v", got, want)
+	}
+}
+
+func TestProviderUnauthorized(t *testing.T) {
+	ts, _ := testServerAndProvider()
+	defer ts.Close()
+
+	p := New("wrong-key", fixer.BaseURL(ts.URL))
+
+	if _, err := p.Latest(context.Background()); err == nil {
+		t.Fatal("expected to get error")
+	}
+}
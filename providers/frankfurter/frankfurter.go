@@ -0,0 +1,219 @@
+// Package frankfurter implements fixer.Exchanger against
+// https://api.frankfurter.app, a free mirror of the ECB reference rates.
+package frankfurter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/peterhellberg/fixer"
+)
+
+// Provider is a fixer.Exchanger backed by api.frankfurter.app
+type Provider struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// New creates a Provider
+func New(options ...func(*Provider)) *Provider {
+	p := &Provider{
+		httpClient: http.DefaultClient,
+		baseURL:    "https://api.frankfurter.app",
+	}
+
+	for _, f := range options {
+		f(p)
+	}
+
+	return p
+}
+
+// HTTPClient changes the HTTP client to the provided *http.Client
+func HTTPClient(hc *http.Client) func(*Provider) {
+	return func(p *Provider) {
+		p.httpClient = hc
+	}
+}
+
+// BaseURL changes the base URL to the provided rawurl
+func BaseURL(rawurl string) func(*Provider) {
+	return func(p *Provider) {
+		p.baseURL = rawurl
+	}
+}
+
+// response is the shape returned by both /latest and the date endpoints
+type response struct {
+	Base  fixer.Currency `json:"base"`
+	Date  string         `json:"date"`
+	Rates fixer.Rates    `json:"rates"`
+}
+
+// Latest foreign exchange reference rates
+func (p *Provider) Latest(ctx context.Context, attributes ...url.Values) (*fixer.Response, error) {
+	return p.get(ctx, "latest", attributes)
+}
+
+// At returns historical rates for the given day
+func (p *Provider) At(ctx context.Context, t time.Time, attributes ...url.Values) (*fixer.Response, error) {
+	return p.get(ctx, t.Format("2006-01-02"), attributes)
+}
+
+// rangeResponse is the shape returned by the /start..end range endpoint
+type rangeResponse struct {
+	Base      fixer.Currency         `json:"base"`
+	StartDate string                 `json:"start_date"`
+	EndDate   string                 `json:"end_date"`
+	Rates     map[string]fixer.Rates `json:"rates"`
+}
+
+// TimeSeries returns daily rates for the closed range [start, end]
+func (p *Provider) TimeSeries(ctx context.Context, start, end time.Time, attributes ...url.Values) (*fixer.TimeSeriesResponse, error) {
+	path := start.Format("2006-01-02") + ".." + end.Format("2006-01-02")
+
+	q := url.Values{}
+
+	for _, a := range attributes {
+		if base := a.Get("base"); base != "" {
+			q.Set("from", base)
+		}
+
+		if symbols := a.Get("symbols"); symbols != "" {
+			q.Set("to", symbols)
+		}
+	}
+
+	rawurl := p.baseURL + "/" + path
+
+	if len(q) > 0 {
+		rawurl += "?" + q.Encode()
+	}
+
+	req, err := http.NewRequest("GET", rawurl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+	req.Header.Add("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fixer.ErrUnexpectedStatus
+	}
+
+	var r rangeResponse
+
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return nil, err
+	}
+
+	base := r.Base
+	if base == "" {
+		base = fixer.EUR
+	}
+
+	startDate, err := time.ParseInLocation("2006-01-02", r.StartDate, time.UTC)
+	if err != nil {
+		return nil, err
+	}
+
+	endDate, err := time.ParseInLocation("2006-01-02", r.EndDate, time.UTC)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := make(map[fixer.Date]fixer.Rates, len(r.Rates))
+
+	for s, v := range r.Rates {
+		d, err := time.ParseInLocation("2006-01-02", s, time.UTC)
+		if err != nil {
+			return nil, err
+		}
+
+		rates[fixer.Date{Time: d}] = v
+	}
+
+	return &fixer.TimeSeriesResponse{
+		Base:      base,
+		StartDate: fixer.Date{Time: startDate},
+		EndDate:   fixer.Date{Time: endDate},
+		Rates:     rates,
+	}, nil
+}
+
+func (p *Provider) get(ctx context.Context, path string, attributes []url.Values) (*fixer.Response, error) {
+	q := url.Values{}
+
+	for _, a := range attributes {
+		if base := a.Get("base"); base != "" {
+			q.Set("from", base)
+		}
+
+		if symbols := a.Get("symbols"); symbols != "" {
+			q.Set("to", symbols)
+		}
+	}
+
+	rawurl := p.baseURL + "/" + path
+
+	if len(q) > 0 {
+		rawurl += "?" + q.Encode()
+	}
+
+	req, err := http.NewRequest("GET", rawurl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+	req.Header.Add("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fixer.ErrUnexpectedStatus
+	}
+
+	var r response
+
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return nil, err
+	}
+
+	base := r.Base
+	if base == "" {
+		base = fixer.EUR
+	}
+
+	date, err := time.ParseInLocation("2006-01-02", r.Date, time.UTC)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fixer.Response{
+		Base:  base,
+		Date:  fixer.Date{Time: date},
+		Rates: r.Rates,
+	}, nil
+}
+
+// Convert amount of from into to, at the latest rates unless fixer.On is given
+func (p *Provider) Convert(ctx context.Context, from, to fixer.Currency, amount float64, opts ...fixer.ConvertOption) (*fixer.Conversion, error) {
+	return fixer.Convert(ctx, p, from, to, amount, opts...)
+}
+
+var _ fixer.Exchanger = (*Provider)(nil)
@@ -0,0 +1,63 @@
+package frankfurter
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/peterhellberg/fixer"
+)
+
+func testServerAndProvider() (*httptest.Server, *Provider) {
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+
+			switch r.URL.String() {
+			case "/latest?from=SEK&to=GBP%2CUSD":
+				w.Write([]byte(`{"amount":1,"base":"SEK","date":"2024-01-02","rates":{"GBP":0.07,"USD":0.09}}`))
+			case "/2012-03-28":
+				w.Write([]byte(`{"amount":1,"base":"EUR","date":"2012-03-28","rates":{}}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+	return ts, New(BaseURL(ts.URL))
+}
+
+func TestProviderLatest(t *testing.T) {
+	ts, p := testServerAndProvider()
+	defer ts.Close()
+
+	resp, err := p.Latest(context.Background(), fixer.Base(fixer.SEK), fixer.Symbols(fixer.USD, fixer.GBP))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := resp.Base, fixer.SEK; got != want {
+		t.Fatalf("resp.Base = %q, want %q", got, want)
+	}
+
+	if got, want := resp.Rates[fixer.GBP], 0.07; got != want {
+		t.Fatalf("resp.Rates[GBP] = %v, want %v", got, want)
+	}
+}
+
+func TestProviderAt(t *testing.T) {
+	ts, p := testServerAndProvider()
+	defer ts.Close()
+
+	date := time.Date(2012, 3, 28, 0, 0, 0, 0, time.UTC)
+
+	resp, err := p.At(context.Background(), date)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := resp.Date.Time, date; !got.Equal(want) {
+		t.Fatalf("resp.Date.Time = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,95 @@
+package fixer
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/peterhellberg/fixer/currency"
+)
+
+// AllCurrencies returns every currency known to the fixer/currency
+// registry, sorted by code. It's meant for building UIs (dropdowns,
+// autocompletion) from the same source of truth StrictCurrencies validates
+// against.
+func AllCurrencies() Currencies {
+	all := currency.All()
+	cs := make(Currencies, len(all))
+
+	for i, m := range all {
+		cs[i] = Currency(m.Code)
+	}
+
+	sort.Slice(cs, func(i, j int) bool { return cs[i] < cs[j] })
+
+	return cs
+}
+
+// StrictCurrencies makes Client.Base and Client.Symbols validate currency
+// codes against the fixer/currency registry, returning
+// ErrCurrencyNotSupported for a code that isn't in it instead of silently
+// forwarding it to the API.
+func StrictCurrencies(strict bool) func(*Client) {
+	return func(c *Client) {
+		c.strictCurrencies = strict
+	}
+}
+
+func (c *Client) validate(cs ...Currency) error {
+	if !c.strictCurrencies {
+		return nil
+	}
+
+	for _, cur := range cs {
+		if cur == "" {
+			continue
+		}
+
+		if _, ok := currency.Lookup(string(cur)); !ok {
+			return ErrCurrencyNotSupported
+		}
+	}
+
+	return nil
+}
+
+// validateQuery validates the base and symbols values already merged into
+// query, when the Client was created with StrictCurrencies(true). It is what
+// Latest, At and TimeSeries call internally, so strict validation applies
+// regardless of whether the query variables came from the package-level
+// Base/Symbols helpers or the Client.Base/Client.Symbols ones.
+func (c *Client) validateQuery(query url.Values) error {
+	if !c.strictCurrencies {
+		return nil
+	}
+
+	cs := []Currency{Currency(query.Get("base"))}
+
+	for _, s := range strings.Split(query.Get("symbols"), ",") {
+		if s != "" {
+			cs = append(cs, Currency(s))
+		}
+	}
+
+	return c.validate(cs...)
+}
+
+// Base validates c, when the Client was created with StrictCurrencies(true),
+// and returns the base query variable for it
+func (c *Client) Base(cur Currency) (url.Values, error) {
+	if err := c.validate(cur); err != nil {
+		return nil, err
+	}
+
+	return Base(cur), nil
+}
+
+// Symbols validates cs, when the Client was created with
+// StrictCurrencies(true), and returns the symbols query variable for them
+func (c *Client) Symbols(cs ...Currency) (url.Values, error) {
+	if err := c.validate(cs...); err != nil {
+		return nil, err
+	}
+
+	return Symbols(cs...), nil
+}
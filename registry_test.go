@@ -0,0 +1,103 @@
+package fixer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAllCurrencies(t *testing.T) {
+	all := AllCurrencies()
+
+	if len(all) == 0 {
+		t.Fatal("AllCurrencies() returned no currencies")
+	}
+
+	for i := 1; i < len(all); i++ {
+		if all[i-1] >= all[i] {
+			t.Fatalf("AllCurrencies() not sorted: %q >= %q", all[i-1], all[i])
+		}
+	}
+}
+
+func TestCurrenciesFilter(t *testing.T) {
+	cs := Currencies{EUR, SEK, USD}
+
+	got := cs.Filter(func(c Currency) bool { return c != SEK })
+
+	if len(got) != 2 {
+		t.Fatalf("len(got) = %d, want 2", len(got))
+	}
+
+	for _, c := range got {
+		if c == SEK {
+			t.Fatal("Filter did not remove SEK")
+		}
+	}
+}
+
+func TestClientBaseAndSymbolsStrict(t *testing.T) {
+	t.Run("lenient by default", func(t *testing.T) {
+		c := NewClient()
+
+		if _, err := c.Base(Currency("XXX")); err != nil {
+			t.Fatalf("c.Base(\"XXX\") error = %v, want nil", err)
+		}
+	})
+
+	t.Run("strict rejects unknown currency", func(t *testing.T) {
+		c := NewClient(StrictCurrencies(true))
+
+		if _, err := c.Base(Currency("XXX")); !errors.Is(err, ErrCurrencyNotSupported) {
+			t.Fatalf("c.Base(\"XXX\") error = %v, want ErrCurrencyNotSupported", err)
+		}
+
+		if _, err := c.Symbols(EUR, Currency("XXX")); !errors.Is(err, ErrCurrencyNotSupported) {
+			t.Fatalf("c.Symbols(EUR, \"XXX\") error = %v, want ErrCurrencyNotSupported", err)
+		}
+	})
+
+	t.Run("strict allows known currency", func(t *testing.T) {
+		c := NewClient(StrictCurrencies(true))
+
+		if _, err := c.Base(EUR); err != nil {
+			t.Fatalf("c.Base(EUR) error = %v, want nil", err)
+		}
+
+		if _, err := c.Symbols(EUR, SEK); err != nil {
+			t.Fatalf("c.Symbols(EUR, SEK) error = %v, want nil", err)
+		}
+	})
+
+	t.Run("strict allows empty currency", func(t *testing.T) {
+		c := NewClient(StrictCurrencies(true))
+
+		if _, err := c.Base(Currency("")); err != nil {
+			t.Fatalf("c.Base(\"\") error = %v, want nil", err)
+		}
+	})
+}
+
+func TestClientLatestStrict(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"base":"EUR","date":"2024-01-02","rates":{"SEK":11.2}}`))
+		}))
+	defer ts.Close()
+
+	c := NewClient(BaseURL(ts.URL), StrictCurrencies(true))
+
+	// Goes through the package-level Base/Symbols helpers, not
+	// Client.Base/Client.Symbols, so this only rejects XXX if Latest itself
+	// validates the query.
+	if _, err := c.Latest(context.Background(), Base(EUR), Symbols(Currency("XXX"))); !errors.Is(err, ErrCurrencyNotSupported) {
+		t.Fatalf("c.Latest(...) error = %v, want ErrCurrencyNotSupported", err)
+	}
+
+	if _, err := c.Latest(context.Background(), Base(EUR), Symbols(SEK)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
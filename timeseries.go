@@ -0,0 +1,237 @@
+package fixer
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// maxTimeSeriesWindowDays is the widest date range a single /timeseries
+// request is allowed to cover
+const maxTimeSeriesWindowDays = 365
+
+// TimeSeriesResponse is daily rates for a range of dates
+type TimeSeriesResponse struct {
+	Base      Currency
+	StartDate Date
+	EndDate   Date
+	Rates     map[Date]Rates
+}
+
+// UnmarshalJSON decodes the {"base":...,"rates":{"2013-01-01":{...}}} shape
+// returned by /timeseries into r
+func (r *TimeSeriesResponse) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Base      Currency         `json:"base"`
+		StartDate string           `json:"start_date"`
+		EndDate   string           `json:"end_date"`
+		Rates     map[string]Rates `json:"rates"`
+	}
+
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	start, err := parseDate(raw.StartDate)
+	if err != nil {
+		return err
+	}
+
+	end, err := parseDate(raw.EndDate)
+	if err != nil {
+		return err
+	}
+
+	rates := make(map[Date]Rates, len(raw.Rates))
+
+	for s, v := range raw.Rates {
+		d, err := parseDate(s)
+		if err != nil {
+			return err
+		}
+
+		rates[d] = v
+	}
+
+	r.Base = raw.Base
+	r.StartDate = start
+	r.EndDate = end
+	r.Rates = rates
+
+	return nil
+}
+
+func parseDate(s string) (Date, error) {
+	if s == "" {
+		return Date{}, nil
+	}
+
+	t, err := time.ParseInLocation("2006-01-02", s, time.UTC)
+	if err != nil {
+		return Date{}, err
+	}
+
+	return Date{t}, nil
+}
+
+// Dates returns every date in the response, sorted chronologically
+func (r *TimeSeriesResponse) Dates() []time.Time {
+	dates := make([]time.Time, 0, len(r.Rates))
+
+	for d := range r.Rates {
+		dates = append(dates, d.Time)
+	}
+
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+
+	return dates
+}
+
+// Series returns the rate quoted for c on each date in the response, in the
+// same chronological order as Dates
+func (r *TimeSeriesResponse) Series(c Currency) []float64 {
+	dates := r.Dates()
+	values := make([]float64, len(dates))
+
+	for i, d := range dates {
+		values[i] = r.Rates[Date{d}][c]
+	}
+
+	return values
+}
+
+// TimeSeries returns daily rates for the closed range [start, end], mapping
+// to the /timeseries?start_date=...&end_date=... endpoint. fixer.io caps a
+// single request to a 365 day window; use Range to walk a wider one.
+func (c *Client) TimeSeries(ctx context.Context, start, end time.Time, attributes ...url.Values) (*TimeSeriesResponse, error) {
+	if c.exchanger != nil {
+		return c.exchanger.TimeSeries(ctx, start, end, attributes...)
+	}
+
+	query := c.query(attributes)
+
+	if err := c.validateQuery(query); err != nil {
+		return nil, err
+	}
+
+	query.Set("start_date", c.date(start))
+	query.Set("end_date", c.date(end))
+
+	req, err := c.request(ctx, "/timeseries", query)
+	if err != nil {
+		return nil, err
+	}
+
+	var r TimeSeriesResponse
+
+	if err := c.doInto(req, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// TimeSeriesIterator walks a range of dates, yielding one (date, rates) pair
+// at a time
+type TimeSeriesIterator interface {
+	// Next advances the iterator and reports whether a Value is available
+	Next() bool
+
+	// Value returns the date and rates the iterator is currently positioned
+	// at. It is only valid after a call to Next that returned true.
+	Value() (time.Time, Rates)
+
+	// Err returns the first error encountered while iterating, if any
+	Err() error
+}
+
+// Range returns a TimeSeriesIterator over [start, end], fetching the
+// underlying data in <=365 day windows and stitching them together so
+// arbitrarily large ranges can be walked with a single loop. step, if
+// greater than zero, thins the output so that consecutive dates are at
+// least step apart (daily data is the default when step is zero).
+func (c *Client) Range(ctx context.Context, start, end time.Time, step time.Duration, attributes ...url.Values) TimeSeriesIterator {
+	return &rangeIterator{
+		ctx:    ctx,
+		client: c,
+		cur:    start,
+		end:    end,
+		step:   step,
+		attrs:  attributes,
+		i:      -1,
+	}
+}
+
+type rangeIterator struct {
+	ctx    context.Context
+	client *Client
+	cur    time.Time
+	end    time.Time
+	step   time.Duration
+	attrs  []url.Values
+
+	resp  *TimeSeriesResponse
+	dates []time.Time
+	i     int
+	last  time.Time
+
+	err error
+}
+
+func (it *rangeIterator) Next() bool {
+	for {
+		it.i++
+
+		if it.i >= len(it.dates) {
+			if it.cur.After(it.end) {
+				return false
+			}
+
+			windowEnd := it.cur.AddDate(0, 0, maxTimeSeriesWindowDays)
+			if windowEnd.After(it.end) {
+				windowEnd = it.end
+			}
+
+			resp, err := it.client.TimeSeries(it.ctx, it.cur, windowEnd, it.attrs...)
+			if err != nil {
+				it.err = err
+				return false
+			}
+
+			it.resp = resp
+			it.dates = resp.Dates()
+			it.i = -1
+			it.cur = windowEnd.AddDate(0, 0, 1)
+
+			continue
+		}
+
+		d := it.dates[it.i]
+
+		if it.step > 0 && !it.last.IsZero() && d.Sub(it.last) < it.step {
+			continue
+		}
+
+		it.last = d
+
+		return true
+	}
+}
+
+func (it *rangeIterator) Value() (time.Time, Rates) {
+	if it.i < 0 || it.i >= len(it.dates) {
+		return time.Time{}, nil
+	}
+
+	d := it.dates[it.i]
+
+	return d, it.resp.Rates[Date{d}]
+}
+
+func (it *rangeIterator) Err() error {
+	return it.err
+}
+
+var _ TimeSeriesIterator = (*rangeIterator)(nil)
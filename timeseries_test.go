@@ -0,0 +1,105 @@
+package fixer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func testServerAndClientForTimeSeries() (*httptest.Server, *Client) {
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+
+			switch r.URL.String() {
+			case "/timeseries?end_date=2013-01-02&start_date=2013-01-01":
+				w.Write([]byte(`{"base":"EUR","start_date":"2013-01-01","end_date":"2013-01-02","rates":{"2013-01-01":{"SEK":8.5},"2013-01-02":{"SEK":8.6}}}`))
+			case "/timeseries?end_date=2020-12-31&start_date=2020-01-01":
+				w.Write([]byte(`{"base":"EUR","start_date":"2020-01-01","end_date":"2020-12-31","rates":{"2020-01-01":{"SEK":10.5}}}`))
+			case "/timeseries?end_date=2021-06-01&start_date=2021-01-01":
+				w.Write([]byte(`{"base":"EUR","start_date":"2021-01-01","end_date":"2021-06-01","rates":{"2021-06-01":{"SEK":10.1}}}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+	return ts, NewClient(BaseURL(ts.URL))
+}
+
+func TestTimeSeries(t *testing.T) {
+	ts, c := testServerAndClientForTimeSeries()
+	defer ts.Close()
+
+	start := time.Date(2013, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2013, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	resp, err := c.TimeSeries(context.Background(), start, end)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := resp.Base, EUR; got != want {
+		t.Fatalf("resp.Base = %q, want %q", got, want)
+	}
+
+	if got, want := resp.Dates(), []time.Time{start, end}; len(got) != len(want) || !got[0].Equal(want[0]) || !got[1].Equal(want[1]) {
+		t.Fatalf("resp.Dates() = %v, want %v", got, want)
+	}
+
+	if got, want := resp.Series(SEK), []float64{8.5, 8.6}; len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("resp.Series(SEK) = %v, want %v", got, want)
+	}
+}
+
+func TestRange(t *testing.T) {
+	ts, c := testServerAndClientForTimeSeries()
+	defer ts.Close()
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	it := c.Range(context.Background(), start, end, 0)
+
+	var dates []time.Time
+
+	for it.Next() {
+		d, rates := it.Value()
+		dates = append(dates, d)
+
+		if _, ok := rates[SEK]; !ok {
+			t.Fatalf("rates[SEK] missing for %v", d)
+		}
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := len(dates), 2; got != want {
+		t.Fatalf("len(dates) = %d, want %d", got, want)
+	}
+
+	if !dates[0].Equal(start) || !dates[1].Equal(end) {
+		t.Fatalf("dates = %v, want [%v %v]", dates, start, end)
+	}
+}
+
+func TestTimeSeriesUsesProvider(t *testing.T) {
+	want := &TimeSeriesResponse{Base: SEK}
+
+	c := NewClient(Provider(fakeExchanger{resp: nil, tsResp: want}))
+
+	start := time.Date(2013, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2013, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	resp, err := c.TimeSeries(context.Background(), start, end)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp != want {
+		t.Fatalf("resp = %v, want %v", resp, want)
+	}
+}
@@ -0,0 +1,181 @@
+package fixer
+
+import (
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RetryPolicy configures WithRetry
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is attempted,
+	// including the first. The zero value means 3.
+	MaxAttempts int
+
+	// BaseBackoff is the starting point for the full-jitter exponential
+	// backoff between attempts. The zero value means 200ms.
+	BaseBackoff time.Duration
+
+	// MaxBackoff caps the backoff between attempts. The zero value means
+	// 10s.
+	MaxBackoff time.Duration
+
+	// RetryOn decides whether a response/error pair should be retried. The
+	// zero value is DefaultRetryOn.
+	RetryOn func(*http.Response, error) bool
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = 3
+	}
+
+	if p.BaseBackoff <= 0 {
+		p.BaseBackoff = 200 * time.Millisecond
+	}
+
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = 10 * time.Second
+	}
+
+	if p.RetryOn == nil {
+		p.RetryOn = DefaultRetryOn
+	}
+
+	return p
+}
+
+// DefaultRetryOn retries connection errors, 5xx responses and 429 Too Many
+// Requests
+func DefaultRetryOn(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	if resp == nil {
+		return false
+	}
+
+	return resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+}
+
+// WithRetry makes the Client retry idempotent GET requests according to
+// policy, honouring a Retry-After response header (in either its
+// delta-seconds or HTTP-date form) when the server sends one.
+func WithRetry(policy RetryPolicy) func(*Client) {
+	policy = policy.withDefaults()
+
+	return func(c *Client) {
+		c.retry = &policy
+	}
+}
+
+// WithRateLimit caps the Client at rps requests per second, with bursts of
+// up to burst, using a token bucket. Cached responses bypass the limiter
+// entirely, since they never reach the HTTP transport.
+func WithRateLimit(rps float64, burst int) func(*Client) {
+	return func(c *Client) {
+		c.limiter = rate.NewLimiter(rate.Limit(rps), burst)
+	}
+}
+
+// wait blocks until the Client's rate limiter, if configured, admits
+// another request. It is called once per attempt, so a retried request is
+// throttled the same as the first.
+func (c *Client) wait(req *http.Request) error {
+	if c.limiter == nil {
+		return nil
+	}
+
+	return c.limiter.Wait(req.Context())
+}
+
+// doHTTP sends req, applying the Client's rate limiter and retry policy (if
+// configured) around the underlying *http.Client
+func (c *Client) doHTTP(req *http.Request) (*http.Response, error) {
+	if c.retry == nil {
+		if err := c.wait(req); err != nil {
+			return nil, err
+		}
+
+		return c.httpClient.Do(req)
+	}
+
+	policy := *c.retry
+
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; attempt < policy.MaxAttempts; attempt++ {
+		if err := c.wait(req); err != nil {
+			return nil, err
+		}
+
+		resp, err = c.httpClient.Do(req)
+
+		if !policy.RetryOn(resp, err) || attempt == policy.MaxAttempts-1 {
+			return resp, err
+		}
+
+		wait := backoff(policy, attempt)
+
+		if resp != nil {
+			if d := retryAfter(resp.Header); d > 0 {
+				wait = d
+			}
+
+			_, _ = io.CopyN(ioutil.Discard, resp.Body, 64)
+			_ = resp.Body.Close()
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	return resp, err
+}
+
+// retryAfter parses a Retry-After header in either its delta-seconds or
+// HTTP-date form, returning zero if it's missing or unparsable.
+func retryAfter(h http.Header) time.Duration {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs <= 0 {
+			return 0
+		}
+
+		return time.Duration(secs) * time.Second
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
+
+// backoff returns a full-jitter exponential backoff duration for the given
+// (0-indexed) attempt
+func backoff(policy RetryPolicy, attempt int) time.Duration {
+	max := policy.BaseBackoff << uint(attempt)
+
+	if max <= 0 || max > policy.MaxBackoff {
+		max = policy.MaxBackoff
+	}
+
+	return time.Duration(rand.Int63n(int64(max)))
+}